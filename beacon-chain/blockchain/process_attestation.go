@@ -0,0 +1,25 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// OnAttestation is the entry point the attestation pool / gossip handler calls for every
+// attestation it accepts, before handing it to fork choice. It runs through the same
+// batched-verification path full blocks use so a lone attestation isn't treated specially;
+// verifyAttestationsBatch already fast-paths a single-element slice to a plain
+// verifyAttestation call.
+func (s *Service) OnAttestation(ctx context.Context, a *ethpb.Attestation) (*ethpb.IndexedAttestation, error) {
+	baseState, err := s.getAttPreState(ctx, a.Data.Target)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pre state")
+	}
+	indexedAtts, err := s.verifyAttestationsBatch(ctx, baseState, []*ethpb.Attestation{a})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not verify attestation")
+	}
+	return indexedAtts[0], nil
+}
@@ -3,8 +3,10 @@ package blockchain
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -14,15 +16,30 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"golang.org/x/sync/singleflight"
 )
 
-// getAttPreState retrieves the att pre state by either from the cache or the DB.
+// checkpointStateGroup deduplicates concurrent cache misses in getAttPreState: when several
+// goroutines ask for the pre-state of the same (epoch, root) checkpoint at once, only one of
+// them regenerates it via stateGen.StateByRoot/state.ProcessSlots, and the rest wait on that
+// single result instead of redoing the work.
+var checkpointStateGroup singleflight.Group
+
+// checkpointSingleflightKey returns the dedupe key for c, unique enough for the purpose given
+// the LRU cache already shards on the same fields.
+func checkpointSingleflightKey(c *ethpb.Checkpoint) string {
+	return fmt.Sprintf("%d:%#x", c.Epoch, c.Root)
+}
+
+// getAttPreState retrieves the att pre state by either from the cache or the DB. On a cache
+// miss under NewStateMgmt, concurrent callers asking for the same checkpoint share a single
+// stateGen.StateByRoot/state.ProcessSlots computation rather than serializing on a shared
+// lock, so unrelated checkpoints no longer block each other.
 func (s *Service) getAttPreState(ctx context.Context, c *ethpb.Checkpoint) (*stateTrie.BeaconState, error) {
-	s.checkpointStateLock.Lock()
-	defer s.checkpointStateLock.Unlock()
 	cachedState, err := s.checkpointState.StateByCheckpoint(c)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get cached checkpoint state")
@@ -32,34 +49,51 @@ func (s *Service) getAttPreState(ctx context.Context, c *ethpb.Checkpoint) (*sta
 	}
 
 	if featureconfig.Get().NewStateMgmt {
-		if !s.stateGen.HasState(ctx, bytesutil.ToBytes32(c.Root)) {
-			if err := s.beaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
-				return nil, errors.Wrap(err, "could not save initial sync blocks")
+		v, err, _ := checkpointStateGroup.Do(checkpointSingleflightKey(c), func() (interface{}, error) {
+			cache.MarkInflightStart()
+			defer cache.MarkInflightDone()
+
+			// Another singleflight caller may have populated the cache while this one was
+			// waiting to become the leader for this key.
+			if cachedState, err := s.checkpointState.StateByCheckpoint(c); err != nil {
+				return nil, errors.Wrap(err, "could not get cached checkpoint state")
+			} else if cachedState != nil {
+				return cachedState, nil
 			}
-			s.clearInitSyncBlocks()
-		}
 
-		baseState, err := s.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(c.Root))
-		if err != nil {
-			return nil, errors.Wrapf(err, "could not get pre state for slot %d", helpers.StartSlot(c.Epoch))
-		}
+			if !s.stateGen.HasState(ctx, bytesutil.ToBytes32(c.Root)) {
+				if err := s.beaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
+					return nil, errors.Wrap(err, "could not save initial sync blocks")
+				}
+				s.clearInitSyncBlocks()
+			}
 
-		if helpers.StartSlot(c.Epoch) > baseState.Slot() {
-			baseState = baseState.Copy()
-			baseState, err = state.ProcessSlots(ctx, baseState, helpers.StartSlot(c.Epoch))
+			baseState, err := s.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(c.Root))
 			if err != nil {
-				return nil, errors.Wrapf(err, "could not process slots up to %d", helpers.StartSlot(c.Epoch))
+				return nil, errors.Wrapf(err, "could not get pre state for slot %d", helpers.StartSlot(c.Epoch))
 			}
-		}
 
-		if err := s.checkpointState.AddCheckpointState(&cache.CheckpointState{
-			Checkpoint: c,
-			State:      baseState,
-		}); err != nil {
-			return nil, errors.Wrap(err, "could not saved checkpoint state to cache")
-		}
+			if helpers.StartSlot(c.Epoch) > baseState.Slot() {
+				baseState = baseState.Copy()
+				baseState, err = state.ProcessSlots(ctx, baseState, helpers.StartSlot(c.Epoch))
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not process slots up to %d", helpers.StartSlot(c.Epoch))
+				}
+			}
 
-		return baseState, nil
+			if err := s.checkpointState.AddCheckpointState(&cache.CheckpointState{
+				Checkpoint: c,
+				State:      baseState,
+			}); err != nil {
+				return nil, errors.Wrap(err, "could not saved checkpoint state to cache")
+			}
+
+			return baseState, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return v.(*stateTrie.BeaconState), nil
 	}
 
 	if featureconfig.Get().CheckHeadState {
@@ -212,3 +246,142 @@ func (s *Service) verifyAttestation(ctx context.Context, baseState *stateTrie.Be
 
 	return indexedAtt, nil
 }
+
+// verifyAttestationsBatch verifies the aggregate BLS signatures of multiple attestations
+// against baseState in a single batched pairing check, instead of calling
+// blocks.VerifyIndexedAttestation once per attestation. Before the signatures and
+// participating public keys are combined, each is multiplied by its own random scalar;
+// this blinding prevents an attacker who controls one of the aggregated public keys from
+// picking it (a "rogue key") to cancel out other signatures or forge the combined one.
+//
+// If the batch fails to verify, every attestation is re-checked individually through
+// verifyAttestation so the caller can isolate the offending attestation and still receive
+// the existing seed-mismatch diagnostic.
+//
+// An attestation with an empty AttestingIndices (an all-zero aggregation bitfield) is
+// rejected outright rather than folded into the batch: it would contribute a no-op term to
+// both sides of the blinded pairing check, so it can't be allowed to ride through as
+// verified inside an otherwise-valid batch the way blocks.VerifyIndexedAttestation already
+// rejects it in the single-attestation path.
+func (s *Service) verifyAttestationsBatch(ctx context.Context, baseState *stateTrie.BeaconState, atts []*ethpb.Attestation) ([]*ethpb.IndexedAttestation, error) {
+	if len(atts) == 0 {
+		return nil, nil
+	}
+	if len(atts) == 1 {
+		indexedAtt, err := s.verifyAttestation(ctx, baseState, atts[0])
+		if err != nil {
+			return nil, err
+		}
+		return []*ethpb.IndexedAttestation{indexedAtt}, nil
+	}
+
+	indexedAtts := make([]*ethpb.IndexedAttestation, len(atts))
+	blindedSigs := make([]bls.Signature, len(atts))
+	blindedPubKeys := make([]bls.PublicKey, len(atts))
+	msgs := make([][32]byte, len(atts))
+
+	for i, a := range atts {
+		committee, err := helpers.BeaconCommitteeFromState(baseState, a.Data.Slot, a.Data.CommitteeIndex)
+		if err != nil {
+			return nil, err
+		}
+		indexedAtt := attestationutil.ConvertToIndexed(ctx, a, committee)
+		if len(indexedAtt.AttestingIndices) == 0 {
+			return nil, fmt.Errorf("attestation at index %d has no attesting indices", i)
+		}
+		indexedAtts[i] = indexedAtt
+
+		domain, err := helpers.Domain(baseState.Fork(), helpers.SlotToEpoch(a.Data.Slot), params.BeaconConfig().DomainBeaconAttester)
+		if err != nil {
+			return nil, err
+		}
+		signingRoot, err := helpers.ComputeSigningRoot(a.Data, domain)
+		if err != nil {
+			return nil, err
+		}
+		msgs[i] = signingRoot
+
+		aggPubKey, err := aggregateAttestingPubKeys(baseState, indexedAtt.AttestingIndices)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := bls.SignatureFromBytes(a.Signature)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not deserialize attestation signature")
+		}
+
+		scalar, err := randBlindingScalar()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate blinding scalar")
+		}
+		blindedSigs[i] = sig.Mul(scalar)
+		blindedPubKeys[i] = aggPubKey.Mul(scalar)
+	}
+
+	aggSig := bls.AggregateSignatures(blindedSigs)
+	verified := aggSig.AggregateVerify(blindedPubKeys, msgs)
+	if verified {
+		return indexedAtts, nil
+	}
+
+	// The batch failed to verify. Re-verify each attestation on its own so the caller learns
+	// which one is responsible, and so the seed-mismatch diagnostic in verifyAttestation still
+	// fires for the offender.
+	for i, a := range atts {
+		if _, err := s.verifyAttestation(ctx, baseState, a); err != nil {
+			return nil, errors.Wrapf(err, "batch signature verification failed, attestation at index %d is invalid", i)
+		}
+	}
+
+	return indexedAtts, nil
+}
+
+// aggregateAttestingPubKeys aggregates the public keys of every validator in indices as
+// recorded in st.
+func aggregateAttestingPubKeys(st *stateTrie.BeaconState, indices []uint64) (bls.PublicKey, error) {
+	pubKeys := make([]bls.PublicKey, len(indices))
+	for i, idx := range indices {
+		p := st.PubkeyAtIndex(idx)
+		pubKey, err := bls.PublicKeyFromBytes(p[:])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not deserialize validator public key")
+		}
+		pubKeys[i] = pubKey
+	}
+	return bls.AggregatePublicKeys(pubKeys), nil
+}
+
+// randBlindingScalar returns a random, non-zero scalar used to blind a signature or public
+// key before it is folded into a batched pairing check.
+func randBlindingScalar() ([]byte, error) {
+	scalar := make([]byte, 32)
+	for {
+		if _, err := rand.Read(scalar); err != nil {
+			return nil, err
+		}
+		for _, b := range scalar {
+			if b != 0 {
+				return scalar, nil
+			}
+		}
+	}
+}
+
+// prefetchNextEpochCheckpointState warms the cache for the epoch following c in the
+// background, so the first attestation that later references it finds a cache hit instead
+// of paying for a StateByRoot/ProcessSlots computation on the hot path. It is a no-op
+// unless featureconfig.Get().EnableCheckpointStatePrefetch is set. Call this whenever fork
+// choice reports a new finalized or justified checkpoint.
+func (s *Service) prefetchNextEpochCheckpointState(c *ethpb.Checkpoint) {
+	if !featureconfig.Get().EnableCheckpointStatePrefetch {
+		return
+	}
+	next := &ethpb.Checkpoint{Epoch: c.Epoch + 1, Root: c.Root}
+	go func() {
+		ctx, cancel := context.WithTimeout(s.ctx, time.Minute)
+		defer cancel()
+		if _, err := s.getAttPreState(ctx, next); err != nil {
+			log.WithError(err).Debug("Could not prefetch next epoch's checkpoint state")
+		}
+	}()
+}
@@ -0,0 +1,170 @@
+package blockchain
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// fakeAttestationDB is the minimal beaconDB stand-in verifyAttestation falls back to when a
+// batch fails to verify and NewStateMgmt/CheckHeadState are both off.
+type fakeAttestationDB struct {
+	st *stateTrie.BeaconState
+}
+
+func (f *fakeAttestationDB) State(ctx context.Context, blockRoot [32]byte) (*stateTrie.BeaconState, error) {
+	return f.st, nil
+}
+
+func (f *fakeAttestationDB) SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) error {
+	return nil
+}
+
+func (f *fakeAttestationDB) Block(ctx context.Context, blockRoot [32]byte) (*ethpb.SignedBeaconBlock, error) {
+	return nil, nil
+}
+
+// attestationFixture builds a well-formed attestation for committee member 0 of committeeIdx,
+// signed with its real key. With badSig set, the key signs a different message instead, so the
+// signature is well-formed but does not verify.
+func attestationFixture(t *testing.T, st *stateTrie.BeaconState, privKeys []bls.SecretKey, committeeIdx uint64, badSig bool) *ethpb.Attestation {
+	committee, err := helpers.BeaconCommitteeFromState(st, st.Slot(), committeeIdx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := &ethpb.AttestationData{
+		Slot:            st.Slot(),
+		CommitteeIndex:  committeeIdx,
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: helpers.SlotToEpoch(st.Slot()), Root: make([]byte, 32)},
+	}
+	domain, err := helpers.Domain(st.Fork(), helpers.SlotToEpoch(st.Slot()), params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingRoot, err := helpers.ComputeSigningRoot(data, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aggBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggBits.SetBitAt(0, true)
+	if badSig {
+		signingRoot[0] ^= 0xff
+	}
+	sig := privKeys[committee[0]].Sign(signingRoot[:]).Marshal()
+
+	return &ethpb.Attestation{AggregationBits: aggBits, Data: data, Signature: sig}
+}
+
+func TestVerifyAttestationsBatch_SingleAttestationUsesFastPath(t *testing.T) {
+	st, privKeys := testutil.DeterministicGenesisState(t, 256)
+	s := &Service{}
+	a := attestationFixture(t, st, privKeys, 0, false)
+
+	indexed, err := s.verifyAttestationsBatch(context.Background(), st, []*ethpb.Attestation{a})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(indexed) != 1 {
+		t.Fatalf("expected 1 indexed attestation, got %d", len(indexed))
+	}
+}
+
+func TestVerifyAttestationsBatch_AllValidSucceedsAsOneAggregate(t *testing.T) {
+	st, privKeys := testutil.DeterministicGenesisState(t, 256)
+	s := &Service{}
+	atts := []*ethpb.Attestation{
+		attestationFixture(t, st, privKeys, 0, false),
+		attestationFixture(t, st, privKeys, 1, false),
+	}
+
+	indexed, err := s.verifyAttestationsBatch(context.Background(), st, atts)
+	if err != nil {
+		t.Fatalf("expected batch verification to succeed, got: %v", err)
+	}
+	if len(indexed) != len(atts) {
+		t.Errorf("expected %d indexed attestations, got %d", len(atts), len(indexed))
+	}
+}
+
+func TestVerifyAttestationsBatch_RejectsEmptyAttestingIndices(t *testing.T) {
+	st, privKeys := testutil.DeterministicGenesisState(t, 256)
+	s := &Service{}
+	empty := attestationFixture(t, st, privKeys, 0, false)
+	committee, err := helpers.BeaconCommitteeFromState(st, st.Slot(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	empty.AggregationBits = bitfield.NewBitlist(uint64(len(committee)))
+
+	atts := []*ethpb.Attestation{
+		empty,
+		attestationFixture(t, st, privKeys, 1, false),
+	}
+
+	if _, err := s.verifyAttestationsBatch(context.Background(), st, atts); err == nil {
+		t.Fatal("expected an attestation with no attesting indices to be rejected")
+	}
+}
+
+func TestVerifyAttestationsBatch_FallsBackAndIdentifiesBadSignature(t *testing.T) {
+	st, privKeys := testutil.DeterministicGenesisState(t, 256)
+	s := &Service{beaconDB: &fakeAttestationDB{st: st}}
+	atts := []*ethpb.Attestation{
+		attestationFixture(t, st, privKeys, 0, false),
+		attestationFixture(t, st, privKeys, 1, true),
+	}
+
+	_, err := s.verifyAttestationsBatch(context.Background(), st, atts)
+	if err == nil {
+		t.Fatal("expected batch verification to fail and fall back to per-attestation verification")
+	}
+	if !strings.Contains(err.Error(), "attestation at index 1 is invalid") {
+		t.Errorf("expected the error to identify attestation 1 as the offender, got: %v", err)
+	}
+}
+
+func TestRandBlindingScalar_ReturnsDistinctNonZeroScalars(t *testing.T) {
+	a, err := randBlindingScalar()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := randBlindingScalar()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a) != 32 || len(b) != 32 {
+		t.Fatalf("expected 32-byte scalars, got lengths %d and %d", len(a), len(b))
+	}
+	allZero := func(s []byte) bool {
+		for _, b := range s {
+			if b != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	if allZero(a) || allZero(b) {
+		t.Error("expected non-zero blinding scalars")
+	}
+	same := true
+	for i := range a {
+		if a[i] != b[i] {
+			same = false
+			break
+		}
+	}
+	if same {
+		t.Error("expected two independently generated blinding scalars to differ")
+	}
+}
@@ -0,0 +1,62 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// onBlock is called by the block-processing pipeline once a block passes state transition,
+// to verify every attestation it carries before handing the resulting indexed attestations
+// to fork choice. Other steps of block processing (state transition, slashing bookkeeping,
+// etc.) happen elsewhere in the pipeline and are unaffected by this change.
+func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock) ([]*ethpb.IndexedAttestation, error) {
+	if signed == nil || signed.Block == nil {
+		return nil, errors.New("nil block")
+	}
+	return s.VerifyAttestationsForBlock(ctx, signed.Block)
+}
+
+// VerifyAttestationsForBlock verifies every attestation in b in as few batched BLS pairing
+// checks as possible instead of one blocks.VerifyIndexedAttestation call per attestation,
+// which is where most of a large block's signature-verification cost comes from.
+// Attestations are grouped by target checkpoint, since a checkpoint pre-state's committee
+// assignments are only valid for attestations that share it, and each group is verified with
+// a single call to verifyAttestationsBatch. Each group's target checkpoint is also reported to
+// updateJustifiedCheckpoint, which prefetches the next epoch's checkpoint state in the
+// background once a new justified checkpoint is observed.
+func (s *Service) VerifyAttestationsForBlock(ctx context.Context, b *ethpb.BeaconBlock) ([]*ethpb.IndexedAttestation, error) {
+	atts := b.Body.Attestations
+	if len(atts) == 0 {
+		return nil, nil
+	}
+
+	groupOrder := make([]string, 0, len(atts))
+	groupedAtts := make(map[string][]*ethpb.Attestation, len(atts))
+	groupCheckpoint := make(map[string]*ethpb.Checkpoint, len(atts))
+	for _, a := range atts {
+		key := checkpointSingleflightKey(a.Data.Target)
+		if _, ok := groupedAtts[key]; !ok {
+			groupOrder = append(groupOrder, key)
+			groupCheckpoint[key] = a.Data.Target
+		}
+		groupedAtts[key] = append(groupedAtts[key], a)
+	}
+
+	indexedAtts := make([]*ethpb.IndexedAttestation, 0, len(atts))
+	for _, key := range groupOrder {
+		baseState, err := s.getAttPreState(ctx, groupCheckpoint[key])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get pre state for block attestation")
+		}
+		indexed, err := s.verifyAttestationsBatch(ctx, baseState, groupedAtts[key])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not batch verify block attestations")
+		}
+		indexedAtts = append(indexedAtts, indexed...)
+		s.updateJustifiedCheckpoint(groupCheckpoint[key])
+	}
+
+	return indexedAtts, nil
+}
@@ -0,0 +1,20 @@
+package blockchain
+
+import (
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// updateJustifiedCheckpoint records c as the latest justified checkpoint fork choice has
+// observed, as derived from the target checkpoints attested to by a processed block's
+// attestations. If c advances on what was previously justified, it kicks off a background
+// prefetch of the next epoch's checkpoint state so the attestations that will reference it
+// don't pay for a StateByRoot/ProcessSlots computation on the hot path.
+func (s *Service) updateJustifiedCheckpoint(c *ethpb.Checkpoint) {
+	s.checkptLock.Lock()
+	defer s.checkptLock.Unlock()
+	if s.justifiedCheckpt != nil && c.Epoch <= s.justifiedCheckpt.Epoch {
+		return
+	}
+	s.justifiedCheckpt = c
+	s.prefetchNextEpochCheckpointState(c)
+}
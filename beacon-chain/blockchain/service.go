@@ -0,0 +1,67 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// Database is the subset of the beacon database the blockchain service needs to verify
+// attestations and look up blocks by root.
+type Database interface {
+	State(ctx context.Context, blockRoot [32]byte) (*stateTrie.BeaconState, error)
+	SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) error
+	Block(ctx context.Context, blockRoot [32]byte) (*ethpb.SignedBeaconBlock, error)
+}
+
+// StateGenerator is the subset of beacon-chain/state/stategen the blockchain service uses
+// to regenerate a checkpoint's pre-state when it isn't already cached.
+type StateGenerator interface {
+	HasState(ctx context.Context, blockRoot [32]byte) bool
+	StateByRoot(ctx context.Context, blockRoot [32]byte) (*stateTrie.BeaconState, error)
+}
+
+// Config options for Service.
+type Config struct {
+	BeaconDB Database
+	StateGen StateGenerator
+}
+
+// Service verifies attestations and blocks for fork choice, and keeps the checkpoint
+// pre-state cache warm for the epoch that follows the latest justified checkpoint it has
+// observed. Other responsibilities of the real blockchain service (block state-transition,
+// fork choice bookkeeping, head tracking) live alongside this one in files not carried by
+// this trimmed copy of the package.
+type Service struct {
+	ctx              context.Context
+	cancel           context.CancelFunc
+	beaconDB         Database
+	stateGen         StateGenerator
+	checkpointState  *cache.CheckpointStateCache
+	checkptLock      sync.RWMutex
+	justifiedCheckpt *ethpb.Checkpoint
+}
+
+// NewService instantiates a new blockchain service, sizing its checkpoint state cache from
+// featureconfig.Get().CheckpointStateCacheBytes (0 keeps the cache's own default budget).
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:             ctx,
+		cancel:          cancel,
+		beaconDB:        cfg.BeaconDB,
+		stateGen:        cfg.StateGen,
+		checkpointState: cache.NewCheckpointStateCache(featureconfig.Get().CheckpointStateCacheBytes),
+	}
+}
+
+// Stop cancels the service's context so any in-flight background prefetches triggered by
+// updateJustifiedCheckpoint exit promptly.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
@@ -0,0 +1,185 @@
+// Package cache defines in-memory caches used by the blockchain package to avoid
+// regenerating or refetching expensive beacon chain state.
+package cache
+
+import (
+	"container/list"
+	"encoding/binary"
+	"errors"
+	"hash/maphash"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+)
+
+// checkpointStateShards is the number of independent LRU shards the cache is split into.
+// Sharding lets unrelated checkpoints be read and evicted without contending on a single
+// lock, which matters once many goroutines are concurrently processing attestations.
+const checkpointStateShards = 16
+
+// defaultCheckpointStateBytes is used when a cache is constructed with a zero byte budget.
+const defaultCheckpointStateBytes = 256 << 20 // 256MB
+
+var (
+	checkpointStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "checkpoint_state_cache_hit",
+		Help: "The total number of cache hits on the checkpoint state cache.",
+	})
+	checkpointStateCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "checkpoint_state_cache_miss",
+		Help: "The total number of cache misses on the checkpoint state cache.",
+	})
+	checkpointStateCacheEviction = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "checkpoint_state_cache_eviction",
+		Help: "The total number of evictions from the checkpoint state cache.",
+	})
+	checkpointStateCacheInflight = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "checkpoint_state_cache_inflight",
+		Help: "The number of checkpoint states currently being computed by a singleflight caller.",
+	})
+)
+
+// MarkInflightStart records that a singleflight caller began computing a checkpoint
+// state, for the checkpoint_state_cache_inflight gauge.
+func MarkInflightStart() { checkpointStateCacheInflight.Inc() }
+
+// MarkInflightDone records that a singleflight computation of a checkpoint state
+// finished, for the checkpoint_state_cache_inflight gauge.
+func MarkInflightDone() { checkpointStateCacheInflight.Dec() }
+
+// CheckpointState associates a checkpoint with the beacon state at its boundary slot.
+type CheckpointState struct {
+	Checkpoint *ethpb.Checkpoint
+	State      *stateTrie.BeaconState
+}
+
+// CheckpointStateCache is a sharded, size-bounded LRU cache of CheckpointState, keyed by
+// (epoch, root). The byte budget is split evenly across the shards, and each shard evicts its
+// own least-recently-used entries once its share is exceeded; a state's size is estimated
+// from the SSZ-encoded length of its validator set (see estimatedStateBytes).
+type CheckpointStateCache struct {
+	shards     [checkpointStateShards]*checkpointShard
+	seed       maphash.Seed
+	byteBudget int64
+}
+
+type checkpointShard struct {
+	mu       sync.Mutex
+	entries  map[[40]byte]*list.Element
+	order    *list.List
+	curBytes int64
+	budget   int64
+}
+
+type checkpointEntry struct {
+	key   [40]byte
+	state *CheckpointState
+	bytes int64
+}
+
+// NewCheckpointStateCache returns a CheckpointStateCache that will hold at most byteBudget
+// bytes of cached states, split evenly across its shards. A byteBudget of 0 uses
+// defaultCheckpointStateBytes.
+func NewCheckpointStateCache(byteBudget int64) *CheckpointStateCache {
+	if byteBudget <= 0 {
+		byteBudget = defaultCheckpointStateBytes
+	}
+	c := &CheckpointStateCache{seed: maphash.MakeSeed(), byteBudget: byteBudget}
+	perShard := byteBudget / checkpointStateShards
+	for i := range c.shards {
+		c.shards[i] = &checkpointShard{
+			entries: make(map[[40]byte]*list.Element),
+			order:   list.New(),
+			budget:  perShard,
+		}
+	}
+	return c
+}
+
+func checkpointKey(c *ethpb.Checkpoint) [40]byte {
+	var key [40]byte
+	binary.LittleEndian.PutUint64(key[:8], c.Epoch)
+	copy(key[8:], c.Root)
+	return key
+}
+
+func (c *CheckpointStateCache) shardFor(key [40]byte) *checkpointShard {
+	var h maphash.Hash
+	h.SetSeed(c.seed)
+	_, _ = h.Write(key[:])
+	return c.shards[h.Sum64()%checkpointStateShards]
+}
+
+// StateByCheckpoint returns the cached state for c, or nil if it isn't cached.
+func (c *CheckpointStateCache) StateByCheckpoint(cp *ethpb.Checkpoint) (*stateTrie.BeaconState, error) {
+	if cp == nil {
+		return nil, errors.New("nil checkpoint")
+	}
+	key := checkpointKey(cp)
+	shard := c.shardFor(key)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	el, ok := shard.entries[key]
+	if !ok {
+		checkpointStateCacheMiss.Inc()
+		return nil, nil
+	}
+	shard.order.MoveToFront(el)
+	checkpointStateCacheHit.Inc()
+	return el.Value.(*checkpointEntry).state.State, nil
+}
+
+// AddCheckpointState inserts cs into the cache, evicting the least-recently-used entries
+// in its shard until the shard's byte budget is satisfied. The newest entry is never evicted,
+// so a shard holding a single state larger than its budget stays over budget.
+func (c *CheckpointStateCache) AddCheckpointState(cs *CheckpointState) error {
+	if cs == nil || cs.Checkpoint == nil {
+		return errors.New("nil checkpoint state")
+	}
+	key := checkpointKey(cs.Checkpoint)
+	shard := c.shardFor(key)
+	size := estimatedStateBytes(cs.State)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	if el, ok := shard.entries[key]; ok {
+		shard.curBytes -= el.Value.(*checkpointEntry).bytes
+		shard.order.Remove(el)
+		delete(shard.entries, key)
+	}
+
+	entry := &checkpointEntry{key: key, state: cs, bytes: size}
+	el := shard.order.PushFront(entry)
+	shard.entries[key] = el
+	shard.curBytes += size
+
+	for shard.curBytes > shard.budget && shard.order.Len() > 1 {
+		oldest := shard.order.Back()
+		if oldest == nil {
+			break
+		}
+		oldestEntry := oldest.Value.(*checkpointEntry)
+		shard.order.Remove(oldest)
+		delete(shard.entries, oldestEntry.key)
+		shard.curBytes -= oldestEntry.bytes
+		checkpointStateCacheEviction.Inc()
+	}
+
+	return nil
+}
+
+// estimatedStateBytes estimates the in-memory footprint of a BeaconState from the
+// SSZ-encoded length of its validator set, which dominates the state's size.
+func estimatedStateBytes(st *stateTrie.BeaconState) int64 {
+	if st == nil {
+		return 0
+	}
+	const bytesPerValidator = 121 // SSZ-encoded length of a single Validator record.
+	const baseOverhead = 1 << 16  // Everything else: balances, randao mixes, history roots, etc.
+	return int64(len(st.Validators())*bytesPerValidator) + baseOverhead
+}
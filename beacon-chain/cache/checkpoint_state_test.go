@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func checkpoint(epoch uint64, root byte) *ethpb.Checkpoint {
+	return &ethpb.Checkpoint{Epoch: epoch, Root: []byte{root}}
+}
+
+func TestCheckpointStateCache_RoundTrip(t *testing.T) {
+	c := NewCheckpointStateCache(0)
+	st, _ := testutil.DeterministicGenesisState(t, 32)
+	cp := checkpoint(1, 1)
+
+	if cached, err := c.StateByCheckpoint(cp); err != nil {
+		t.Fatal(err)
+	} else if cached != nil {
+		t.Fatal("expected cache miss before any insert")
+	}
+
+	if err := c.AddCheckpointState(&CheckpointState{Checkpoint: cp, State: st}); err != nil {
+		t.Fatal(err)
+	}
+
+	cached, err := c.StateByCheckpoint(cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached != st {
+		t.Error("expected cached state to be the same instance that was inserted")
+	}
+}
+
+func TestCheckpointStateCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 32)
+	entryBytes := estimatedStateBytes(st)
+
+	// Give the single shard that every checkpoint below hashes into room for two entries
+	// only, so the third insert must evict one of the first two.
+	c := NewCheckpointStateCache(entryBytes*2 + 1)
+	for i := range c.shards {
+		c.shards[i].budget = entryBytes * 2
+	}
+
+	// shardFor hashes on the full (epoch, root) key, so pick three checkpoints that the
+	// cache's own hash happens to route to the same shard, guaranteeing they compete for
+	// that one shard's budget instead of evicting independently in different shards.
+	var roots []byte
+	target := c.shardFor(checkpointKey(checkpoint(1, 0)))
+	for b := 0; len(roots) < 3 && b < 256; b++ {
+		cp := checkpoint(1, byte(b))
+		if c.shardFor(checkpointKey(cp)) == target {
+			roots = append(roots, byte(b))
+		}
+	}
+	if len(roots) < 3 {
+		t.Fatal("could not find three checkpoints hashing to the same shard")
+	}
+	cpA := checkpoint(1, roots[0])
+	cpB := checkpoint(1, roots[1])
+	cpC := checkpoint(1, roots[2])
+
+	mustAdd := func(cp *ethpb.Checkpoint) {
+		if err := c.AddCheckpointState(&CheckpointState{Checkpoint: cp, State: st}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mustAdd(cpA)
+	mustAdd(cpB)
+
+	// Touch cpA so it becomes more recently used than cpB.
+	if _, err := c.StateByCheckpoint(cpA); err != nil {
+		t.Fatal(err)
+	}
+
+	mustAdd(cpC)
+
+	cachedA, err := c.StateByCheckpoint(cpA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cachedA == nil {
+		t.Error("expected recently-used cpA to survive eviction")
+	}
+
+	cachedB, err := c.StateByCheckpoint(cpB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cachedB != nil {
+		t.Error("expected least-recently-used cpB to be evicted")
+	}
+
+	cachedC, err := c.StateByCheckpoint(cpC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cachedC == nil {
+		t.Error("expected just-inserted cpC to be cached")
+	}
+}
+
+func TestCheckpointStateCache_AddCheckpointStateRejectsNil(t *testing.T) {
+	c := NewCheckpointStateCache(0)
+	if err := c.AddCheckpointState(nil); err == nil {
+		t.Error("expected error adding nil checkpoint state")
+	}
+	if err := c.AddCheckpointState(&CheckpointState{}); err == nil {
+		t.Error("expected error adding checkpoint state with nil checkpoint")
+	}
+}
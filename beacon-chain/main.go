@@ -0,0 +1,109 @@
+package main
+
+import (
+	"os"
+	"runtime"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/urfave/cli.v2"
+	"gopkg.in/urfave/cli.v2/altsrc"
+)
+
+var log = logrus.WithField("prefix", "main")
+
+func startNode(ctx *cli.Context) error {
+	featureconfig.ConfigureBeaconChain(ctx)
+	verbosity := ctx.String(cmd.VerbosityFlag.Name)
+	level, err := logrus.ParseLevel(verbosity)
+	if err != nil {
+		return err
+	}
+	logrus.SetLevel(level)
+	beacon, err := node.NewBeaconNode(ctx)
+	if err != nil {
+		return err
+	}
+	beacon.Start()
+	return nil
+}
+
+var appFlags = []cli.Flag{
+	cmd.VerbosityFlag,
+	cmd.DataDirFlag,
+	cmd.EnableTracingFlag,
+	cmd.TracingProcessNameFlag,
+	cmd.TracingEndpointFlag,
+	cmd.TraceSampleFractionFlag,
+	cmd.LogFileName,
+	cmd.LogFormat,
+	cmd.ClearDB,
+	cmd.ForceClearDB,
+	cmd.ConfigFileFlag,
+	debug.PProfFlag,
+	debug.PProfAddrFlag,
+	debug.PProfPortFlag,
+	debug.MemProfileRateFlag,
+	debug.CPUProfileFlag,
+	debug.TraceFlag,
+	flags.RPCHost,
+	flags.RPCPort,
+	flags.MonitoringPortFlag,
+}
+
+func init() {
+	appFlags = cmd.WrapFlags(append(appFlags, featureconfig.BeaconChainFlags...))
+}
+
+func main() {
+	app := cli.App{}
+	app.Name = "beacon-chain"
+	app.Usage = "launches an Ethereum Serenity beacon chain server"
+	app.Version = version.GetVersion()
+	app.Flags = appFlags
+	app.Action = startNode
+	app.Before = func(ctx *cli.Context) error {
+		// Load any flags from file, if specified.
+		if ctx.IsSet(cmd.ConfigFileFlag.Name) {
+			if err := altsrc.InitInputSourceWithContext(
+				appFlags,
+				altsrc.NewYamlSourceFromFlagFunc(
+					cmd.ConfigFileFlag.Name))(ctx); err != nil {
+				return err
+			}
+		}
+
+		// --log-format takes one or more comma-separated sinks (e.g. "json,otlp:warn"), the
+		// same registry slasher uses, so this binary and the slasher stay consistent. See
+		// shared/logutil for the available sinks.
+		if err := logutil.ConfigureFormatter(ctx, ctx.String(cmd.LogFormat.Name)); err != nil {
+			return err
+		}
+
+		logFileName := ctx.String(cmd.LogFileName.Name)
+		if logFileName != "" {
+			if err := logutil.ConfigurePersistentLogging(logFileName); err != nil {
+				log.WithError(err).Error("Failed to configuring logging to disk.")
+			}
+		}
+
+		runtime.GOMAXPROCS(runtime.NumCPU())
+		return debug.Setup(ctx)
+	}
+
+	app.After = func(ctx *cli.Context) error {
+		debug.Exit(ctx)
+		return nil
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
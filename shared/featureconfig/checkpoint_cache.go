@@ -0,0 +1,34 @@
+package featureconfig
+
+import "gopkg.in/urfave/cli.v2"
+
+// EnableCheckpointStatePrefetchFlag turns on the background prefetcher that warms next
+// epoch's checkpoint pre-state as soon as a new justified checkpoint is observed, trading
+// idle-time CPU for lower latency on the next attestation that needs it. New finalized
+// checkpoints do not trigger a prefetch.
+var EnableCheckpointStatePrefetchFlag = &cli.BoolFlag{
+	Name:  "enable-checkpoint-state-prefetch",
+	Usage: "Enables prefetching the next epoch's checkpoint pre-state in the background as new justified checkpoints arrive",
+}
+
+// CheckpointStateCacheBytesFlag sets the byte budget of the sharded checkpoint state cache.
+// A value of 0 leaves the cache's own default budget in place.
+var CheckpointStateCacheBytesFlag = &cli.Int64Flag{
+	Name:  "checkpoint-state-cache-bytes",
+	Usage: "Byte budget for the in-memory checkpoint pre-state cache; 0 uses the cache's default budget",
+}
+
+// configureCheckpointStatePrefetch is called from ConfigureBeaconChain and sets
+// Flags.EnableCheckpointStatePrefetch from EnableCheckpointStatePrefetchFlag and
+// Flags.CheckpointStateCacheBytes from CheckpointStateCacheBytesFlag.
+func configureCheckpointStatePrefetch(ctx *cli.Context, cfg *Flags) {
+	if ctx.Bool(EnableCheckpointStatePrefetchFlag.Name) {
+		log.Warn("Enabling checkpoint state prefetching")
+		cfg.EnableCheckpointStatePrefetch = true
+	}
+	cfg.CheckpointStateCacheBytes = ctx.Int64(CheckpointStateCacheBytesFlag.Name)
+}
+
+func init() {
+	BeaconChainFlags = append(BeaconChainFlags, EnableCheckpointStatePrefetchFlag, CheckpointStateCacheBytesFlag)
+}
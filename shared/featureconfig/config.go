@@ -0,0 +1,62 @@
+// Package featureconfig defines a global config struct that hold the process-wide feature
+// flags that gate opt-in behavior across the beacon chain and slasher binaries, along with
+// the CLI flags used to turn them on and the per-binary Configure functions that populate it.
+package featureconfig
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/urfave/cli.v2"
+)
+
+var log = logrus.WithField("prefix", "flags")
+
+// Flags is a struct holding the process-wide feature flag configuration, retrieved via Get
+// and set once at start-up via one of the Configure functions below.
+type Flags struct {
+	NewStateMgmt                  bool  // NewStateMgmt enables the new, stategen-backed checkpoint pre-state lookup path.
+	CheckHeadState                bool  // CheckHeadState enables checking the current head state before loading a checkpoint pre-state from the DB.
+	EnableCheckpointStatePrefetch bool  // EnableCheckpointStatePrefetch enables background prefetching of next epoch's checkpoint state.
+	CheckpointStateCacheBytes     int64 // CheckpointStateCacheBytes sets the checkpoint state cache's byte budget; 0 uses its default.
+}
+
+var featureConfig *Flags
+var featureConfigLock sync.RWMutex
+
+// Get the current feature flag configuration.
+func Get() *Flags {
+	featureConfigLock.RLock()
+	defer featureConfigLock.RUnlock()
+	if featureConfig == nil {
+		return &Flags{}
+	}
+	return featureConfig
+}
+
+// Init sets the global feature flag config to the passed in cfg.
+func Init(cfg *Flags) {
+	featureConfigLock.Lock()
+	defer featureConfigLock.Unlock()
+	featureConfig = cfg
+}
+
+// BeaconChainFlags is the list of feature-config CLI flags exposed by the beacon-chain binary.
+var BeaconChainFlags []cli.Flag
+
+// SlasherFlags is the list of feature-config CLI flags exposed by the slasher binary.
+var SlasherFlags []cli.Flag
+
+// ConfigureBeaconChain sets the global feature flag config according to what's read from
+// the CLI context for the beacon-chain binary.
+func ConfigureBeaconChain(ctx *cli.Context) {
+	cfg := &Flags{}
+	configureCheckpointStatePrefetch(ctx, cfg)
+	Init(cfg)
+}
+
+// ConfigureSlasher sets the global feature flag config according to what's read from the
+// CLI context for the slasher binary. The slasher has no feature flags of its own yet.
+func ConfigureSlasher(ctx *cli.Context) {
+	Init(&Flags{})
+}
@@ -0,0 +1,33 @@
+package logutil
+
+import (
+	joonix "github.com/joonix/log"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/sirupsen/logrus"
+	prefixed "github.com/x-cray/logrus-prefixed-formatter"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func init() {
+	RegisterFormatter("text", newTextFormatter)
+	RegisterFormatter("fluentd", newFluentdFormatter)
+	RegisterFormatter("json", newJSONFormatter)
+}
+
+func newTextFormatter(ctx *cli.Context) (logrus.Formatter, error) {
+	formatter := new(prefixed.TextFormatter)
+	formatter.TimestampFormat = "2006-01-02 15:04:05"
+	formatter.FullTimestamp = true
+	// If persistent log files are written - we disable the log messages coloring because
+	// the colors are ANSI codes and seen as Gibberish in the log files.
+	formatter.DisableColors = ctx.String(cmd.LogFileName.Name) != ""
+	return formatter, nil
+}
+
+func newFluentdFormatter(_ *cli.Context) (logrus.Formatter, error) {
+	return joonix.NewFormatter(), nil
+}
+
+func newJSONFormatter(_ *cli.Context) (logrus.Formatter, error) {
+	return &logrus.JSONFormatter{}, nil
+}
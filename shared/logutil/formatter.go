@@ -0,0 +1,119 @@
+package logutil
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/urfave/cli.v2"
+)
+
+var log = logrus.WithField("prefix", "logutil")
+
+// FormatterFactory builds a logrus.Formatter for a named log sink, given the parsed CLI
+// flags. It is called once per sink named in --log-format.
+type FormatterFactory func(ctx *cli.Context) (logrus.Formatter, error)
+
+var formattersMu sync.RWMutex
+var formatters = map[string]FormatterFactory{}
+
+// RegisterFormatter makes a log sink available under name to the --log-format flag.
+// Built-in sinks call this from an init() in the same package; callers outside
+// shared/logutil can use it to plug in additional sinks of their own.
+func RegisterFormatter(name string, factory FormatterFactory) {
+	formattersMu.Lock()
+	defer formattersMu.Unlock()
+	formatters[name] = factory
+}
+
+// sinkSpec is a single entry of a --log-format value, e.g. "otlp" or "otlp:warn".
+type sinkSpec struct {
+	name     string
+	minLevel logrus.Level
+}
+
+func parseSinkSpec(raw string) (sinkSpec, error) {
+	parts := strings.SplitN(raw, ":", 2)
+	spec := sinkSpec{name: parts[0], minLevel: logrus.TraceLevel}
+	if len(parts) == 2 {
+		lvl, err := logrus.ParseLevel(parts[1])
+		if err != nil {
+			return sinkSpec{}, errors.Wrapf(err, "invalid log level for sink %s", parts[0])
+		}
+		spec.minLevel = lvl
+	}
+	return spec, nil
+}
+
+// ConfigureFormatter parses a comma-separated --log-format value such as
+// "json,otlp:warn,logfmt" into its constituent sinks and installs the combined result as
+// logrus' global formatter. Every named sink runs on every log entry, gated by its own
+// optional minimum level; the rendering of the first sink that accepts the entry is what reaches
+// stdout/the log file, the rest run purely for their side effects (e.g. shipping records to
+// a collector).
+func ConfigureFormatter(ctx *cli.Context, rawFormats string) error {
+	rawSinks := strings.Split(rawFormats, ",")
+	sinks := make([]leveledFormatter, 0, len(rawSinks))
+	for _, raw := range rawSinks {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		spec, err := parseSinkSpec(raw)
+		if err != nil {
+			return err
+		}
+		formattersMu.RLock()
+		factory, ok := formatters[spec.name]
+		formattersMu.RUnlock()
+		if !ok {
+			return errors.Errorf("unknown log format %q", spec.name)
+		}
+		formatter, err := factory(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "could not configure %q log sink", spec.name)
+		}
+		sinks = append(sinks, leveledFormatter{formatter: formatter, minLevel: spec.minLevel})
+	}
+	if len(sinks) == 0 {
+		return errors.New("--log-format must name at least one sink")
+	}
+	logrus.SetFormatter(&multiFormatter{sinks: sinks})
+	return nil
+}
+
+type leveledFormatter struct {
+	formatter logrus.Formatter
+	minLevel  logrus.Level
+}
+
+// multiFormatter fans a single log entry out to every configured sink.
+type multiFormatter struct {
+	sinks []leveledFormatter
+}
+
+// Format satisfies logrus.Formatter. It returns the rendering produced by the first sink
+// whose level filter accepts entry, since logrus only writes one []byte per entry; every
+// other accepting sink still formats the entry for its own side effects.
+func (m *multiFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	var out []byte
+	for _, sink := range m.sinks {
+		if entry.Level > sink.minLevel {
+			continue
+		}
+		b, err := sink.formatter.Format(entry)
+		if err != nil {
+			return nil, err
+		}
+		if out == nil {
+			out = b
+		}
+	}
+	if out == nil {
+		// Every sink filtered this entry out; return an empty, non-nil slice, so nothing is
+		// written for the entry and logrus' writer doesn't choke on a nil slice.
+		return []byte{}, nil
+	}
+	return out, nil
+}
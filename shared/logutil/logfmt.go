@@ -0,0 +1,58 @@
+package logutil
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func init() {
+	RegisterFormatter("logfmt", newLogfmtFormatter)
+}
+
+// logfmtFormatter renders entries as key=value pairs, one line per entry, the format
+// expected by most log aggregators that don't speak JSON.
+type logfmtFormatter struct{}
+
+func newLogfmtFormatter(_ *cli.Context) (logrus.Formatter, error) {
+	return &logfmtFormatter{}, nil
+}
+
+// Format satisfies logrus.Formatter.
+func (f *logfmtFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("ts=")
+	buf.WriteString(strconv.FormatInt(entry.Time.Unix(), 10))
+	buf.WriteString(" level=")
+	buf.WriteString(entry.Level.String())
+	buf.WriteString(" msg=")
+	writeLogfmtValue(&buf, entry.Message)
+
+	keys := make([]string, 0, len(entry.Data))
+	for k := range entry.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		buf.WriteByte(' ')
+		buf.WriteString(k)
+		buf.WriteByte('=')
+		writeLogfmtValue(&buf, entry.Data[k])
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+func writeLogfmtValue(buf *bytes.Buffer, v interface{}) {
+	s := fmt.Sprintf("%v", v)
+	if s == "" || strings.ContainsAny(s, " =\"") {
+		buf.WriteString(strconv.Quote(s))
+		return
+	}
+	buf.WriteString(s)
+}
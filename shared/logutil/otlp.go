@@ -0,0 +1,63 @@
+package logutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	loggingpb "github.com/prysmaticlabs/prysm/proto/logging"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func init() {
+	RegisterFormatter("otlp", newOTLPFormatter)
+}
+
+// otlpFormatter ships every entry it is asked to format to an OTLP-style collector over
+// gRPC, reusing the tracing endpoint already configured for the process so operators don't
+// need a second endpoint flag just for logs. It never blocks log output on the network
+// call: each failed export is logged as a warning and otherwise swallowed.
+type otlpFormatter struct {
+	client loggingpb.LogExporterClient
+}
+
+func newOTLPFormatter(ctx *cli.Context) (logrus.Formatter, error) {
+	endpoint := ctx.String(cmd.TracingEndpointFlag.Name)
+	if endpoint == "" {
+		return nil, fmt.Errorf("--%s must be set to use the otlp log sink", cmd.TracingEndpointFlag.Name)
+	}
+	conn, err := grpc.Dial(endpoint, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	return &otlpFormatter{client: loggingpb.NewLogExporterClient(conn)}, nil
+}
+
+// Format satisfies logrus.Formatter. The returned bytes are a plain-text rendering in case
+// this sink ends up being the one whose output logrus actually writes out; the exported
+// copy sent to the collector is what downstream aggregation is expected to consume.
+func (f *otlpFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	attrs := make(map[string]string, len(entry.Data))
+	for k, v := range entry.Data {
+		attrs[k] = fmt.Sprintf("%v", v)
+	}
+	record := &loggingpb.LogRecord{
+		TimeUnixNano: entry.Time.UnixNano(),
+		Severity:     entry.Level.String(),
+		Body:         entry.Message,
+		Attributes:   attrs,
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		if _, err := f.client.Export(ctx, record); err != nil {
+			log.WithError(err).Warn("Could not export log record to OTLP collector")
+		}
+	}()
+
+	return []byte(fmt.Sprintf("%s %s %s\n", entry.Time.Format(time.RFC3339), entry.Level, entry.Message)), nil
+}
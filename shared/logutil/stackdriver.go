@@ -0,0 +1,54 @@
+package logutil
+
+import (
+	"encoding/json"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/urfave/cli.v2"
+)
+
+func init() {
+	RegisterFormatter("stackdriver", newStackdriverFormatter)
+}
+
+// stackdriverFormatter renders entries as the structured JSON expected by GCP Cloud
+// Logging, mapping logrus levels onto Stackdriver severities.
+type stackdriverFormatter struct{}
+
+func newStackdriverFormatter(_ *cli.Context) (logrus.Formatter, error) {
+	return &stackdriverFormatter{}, nil
+}
+
+// stackdriverSeverity maps a logrus level to the severity strings Cloud Logging expects.
+// See https://cloud.google.com/logging/docs/reference/v2/rest/v2/LogEntry#LogSeverity.
+func stackdriverSeverity(level logrus.Level) string {
+	switch level {
+	case logrus.PanicLevel, logrus.FatalLevel:
+		return "CRITICAL"
+	case logrus.ErrorLevel:
+		return "ERROR"
+	case logrus.WarnLevel:
+		return "WARNING"
+	case logrus.InfoLevel:
+		return "INFO"
+	default:
+		return "DEBUG"
+	}
+}
+
+// Format satisfies logrus.Formatter.
+func (f *stackdriverFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	fields := make(map[string]interface{}, len(entry.Data)+2)
+	for k, v := range entry.Data {
+		fields[k] = v
+	}
+	fields["severity"] = stackdriverSeverity(entry.Level)
+	fields["message"] = entry.Message
+	fields["timestamp"] = entry.Time.UTC().Format("2006-01-02T15:04:05.000000000Z")
+
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
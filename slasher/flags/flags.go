@@ -0,0 +1,76 @@
+// Package flags defines command-line flags specific to the slasher service beyond those
+// already declared in shared/cmd and slasher/main.go.
+package flags
+
+import (
+	"gopkg.in/urfave/cli.v2"
+)
+
+var (
+	// MonitoringPortFlag defines the port on which to serve prometheus metrics.
+	MonitoringPortFlag = &cli.IntFlag{
+		Name:  "monitoring-port",
+		Usage: "Port used to listening and respond metrics for monitoring.",
+		Value: 9096,
+	}
+	// RPCPort defines the port on which the slasher's own gRPC server listens.
+	RPCPort = &cli.StringFlag{
+		Name:  "rpc-port",
+		Usage: "RPC port exposed by a slasher server",
+		Value: "4002",
+	}
+	// KeyFlag defines a flag for the password/key to unlock the slasher's validator database.
+	KeyFlag = &cli.StringFlag{
+		Name:  "unencrypted-keys",
+		Usage: "Path to unencrypted keys for the slasher database",
+	}
+	// RebuildSpanMapsFlag rebuilds spanmaps for all of the validators from archived attestations in the slasher DB.
+	RebuildSpanMapsFlag = &cli.BoolFlag{
+		Name:  "rebuild-spanmaps",
+		Usage: "Rebuilds spanmaps for all of the validators from archived attestations in the slasher DB",
+	}
+	// BeaconCertFlag defines a flag for the beacon API certificate.
+	BeaconCertFlag = &cli.StringFlag{
+		Name:  "beacon-tls-cert",
+		Usage: "Certificate for secure gRPC connections to the beacon node",
+	}
+	// BeaconRPCProviderFlag defines a flag for the beacon host ip or address.
+	BeaconRPCProviderFlag = &cli.StringFlag{
+		Name:  "beacon-rpc-provider",
+		Usage: "Beacon node RPC provider endpoint",
+		Value: "127.0.0.1:4000",
+	}
+	// RPCGatewayHost specifies the host on which the slasher gRPC-gateway REST/SSE bridge listens.
+	RPCGatewayHost = &cli.StringFlag{
+		Name:  "grpc-gateway-host",
+		Usage: "Host on which the gRPC gateway for the slasher RPC server runs on",
+		Value: "127.0.0.1",
+	}
+	// RPCGatewayPort enables a gRPC-gateway REST/SSE bridge in front of the slasher RPC server on the given port.
+	RPCGatewayPort = &cli.IntFlag{
+		Name:  "grpc-gateway-port",
+		Usage: "Enables a gRPC gateway server on this port, exposing the slasher RPC server over REST and server-sent events",
+		Value: 5366,
+	}
+	// GRPCGatewayCorsDomain sets the value of the Access-Control-Allow-Origin header on gRPC gateway responses.
+	GRPCGatewayCorsDomain = &cli.StringFlag{
+		Name:  "grpc-gateway-corsdomain",
+		Usage: "Comma separated list of domains from which to accept cross origin requests (browser enforced)",
+		Value: "http://localhost:4242,http://localhost:7500",
+	}
+	// RPCTLSCertFlag defines the TLS certificate used to serve the slasher's own gRPC server.
+	RPCTLSCertFlag = &cli.StringFlag{
+		Name:  "tls-cert",
+		Usage: "Certificate for secure gRPC connections to the slasher RPC server",
+	}
+	// RPCTLSKeyFlag defines the TLS key used to serve the slasher's own gRPC server.
+	RPCTLSKeyFlag = &cli.StringFlag{
+		Name:  "tls-key",
+		Usage: "Key for secure gRPC connections to the slasher RPC server",
+	}
+	// DisableRPCFlag disables the slasher slashing-subscription gRPC/REST server entirely.
+	DisableRPCFlag = &cli.BoolFlag{
+		Name:  "disable-slasher-rpc",
+		Usage: "Disables the slasher gRPC/REST server that streams detected slashings to subscribers",
+	}
+)
@@ -1,11 +1,9 @@
 package main
 
 import (
-	"fmt"
 	"os"
 	"runtime"
 
-	joonix "github.com/joonix/log"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -14,7 +12,6 @@ import (
 	"github.com/prysmaticlabs/prysm/slasher/flags"
 	"github.com/prysmaticlabs/prysm/slasher/node"
 	"github.com/sirupsen/logrus"
-	prefixed "github.com/x-cray/logrus-prefixed-formatter"
 	"gopkg.in/urfave/cli.v2"
 	"gopkg.in/urfave/cli.v2/altsrc"
 )
@@ -61,6 +58,12 @@ var appFlags = []cli.Flag{
 	flags.RebuildSpanMapsFlag,
 	flags.BeaconCertFlag,
 	flags.BeaconRPCProviderFlag,
+	flags.RPCGatewayHost,
+	flags.RPCGatewayPort,
+	flags.GRPCGatewayCorsDomain,
+	flags.RPCTLSCertFlag,
+	flags.RPCTLSKeyFlag,
+	flags.DisableRPCFlag,
 }
 
 func init() {
@@ -85,25 +88,11 @@ func main() {
 			}
 		}
 
-		format := ctx.String(cmd.LogFormat.Name)
-		switch format {
-		case "text":
-			formatter := new(prefixed.TextFormatter)
-			formatter.TimestampFormat = "2006-01-02 15:04:05"
-			formatter.FullTimestamp = true
-			// If persistent log files are written - we disable the log messages coloring because
-			// the colors are ANSI codes and seen as Gibberish in the log files.
-			formatter.DisableColors = ctx.String(cmd.LogFileName.Name) != ""
-			logrus.SetFormatter(formatter)
-			break
-		case "fluentd":
-			logrus.SetFormatter(joonix.NewFormatter())
-			break
-		case "json":
-			logrus.SetFormatter(&logrus.JSONFormatter{})
-			break
-		default:
-			return fmt.Errorf("unknown log format %s", format)
+		// --log-format takes one or more comma-separated sinks (e.g. "json,otlp:warn") so a
+		// single slasher process can log locally while also streaming structured events to
+		// an aggregator. See shared/logutil for the registry of available sinks.
+		if err := logutil.ConfigureFormatter(ctx, ctx.String(cmd.LogFormat.Name)); err != nil {
+			return err
 		}
 
 		logFileName := ctx.String(cmd.LogFileName.Name)
@@ -0,0 +1,121 @@
+// Package node assembles the services a slasher needs to run — its database, its
+// slashing detector, and its RPC/REST/SSE surface — and runs them together until the
+// process is asked to shut down.
+package node
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+	"github.com/prysmaticlabs/prysm/slasher/flags"
+	"github.com/prysmaticlabs/prysm/slasher/rpc"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/urfave/cli.v2"
+)
+
+var log = logrus.WithField("prefix", "node")
+
+// SlasherNode defines a struct that holds the services a slasher relies on: its
+// database, its slashing detector, and, unless disabled, its RPC/REST/SSE server.
+type SlasherNode struct {
+	cliCtx     *cli.Context
+	ctx        context.Context
+	cancel     context.CancelFunc
+	db         *db.Store
+	detector   *detection.Service
+	rpcService *rpc.Service
+}
+
+// NewSlasherNode creates a new node instance, opening the slasher database, creating the
+// slashing detector, and, unless --disable-slasher-rpc is set, registering the RPC service
+// that exposes detected slashings to subscribers.
+func NewSlasherNode(cliCtx *cli.Context) (*SlasherNode, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	slasherDB, err := db.NewDB(ctx, cliCtx.String(cmd.DataDirFlag.Name))
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not open slasher database")
+	}
+
+	detector, err := detection.NewService(ctx, &detection.Config{SlasherDB: slasherDB})
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not start slashing detector")
+	}
+
+	n := &SlasherNode{
+		cliCtx:   cliCtx,
+		ctx:      ctx,
+		cancel:   cancel,
+		db:       slasherDB,
+		detector: detector,
+	}
+
+	if !cliCtx.Bool(flags.DisableRPCFlag.Name) {
+		n.registerRPCService()
+	} else {
+		log.Warn("RPC/REST API disabled via --disable-slasher-rpc; detected slashings won't be queryable or streamable")
+	}
+
+	return n, nil
+}
+
+// registerRPCService builds the RPC service's configuration from CLI flags and wires it to
+// the detector it streams slashings from.
+func (s *SlasherNode) registerRPCService() {
+	corsDomains := strings.Split(s.cliCtx.String(flags.GRPCGatewayCorsDomain.Name), ",")
+	cfg := &rpc.Config{
+		Host:                 "127.0.0.1",
+		Port:                 s.cliCtx.String(flags.RPCPort.Name),
+		CertFlag:             s.cliCtx.String(flags.RPCTLSCertFlag.Name),
+		KeyFlag:              s.cliCtx.String(flags.RPCTLSKeyFlag.Name),
+		GatewayHost:          s.cliCtx.String(flags.RPCGatewayHost.Name),
+		GatewayPort:          s.cliCtx.Int(flags.RPCGatewayPort.Name),
+		GatewayCorsDomains:   corsDomains,
+		SlasherDB:            s.detector,
+		AttesterSlashingFeed: s.detector.AttesterSlashingFeed(),
+		ProposerSlashingFeed: s.detector.ProposerSlashingFeed(),
+	}
+	s.rpcService = rpc.NewService(s.ctx, cfg)
+}
+
+// Start the slasher node's services and block until it receives a shutdown signal.
+func (s *SlasherNode) Start() {
+	s.detector.Start()
+	if s.rpcService != nil {
+		s.rpcService.Start()
+	}
+	s.waitForTermination()
+}
+
+// waitForTermination blocks until SIGINT/SIGTERM, then closes every service.
+func (s *SlasherNode) waitForTermination() {
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	<-sigc
+	log.Info("Got interrupt, shutting down...")
+	s.Close()
+}
+
+// Close stops every service the node started, in the reverse order they were started.
+func (s *SlasherNode) Close() {
+	s.cancel()
+	if s.rpcService != nil {
+		if err := s.rpcService.Stop(); err != nil {
+			log.WithError(err).Error("Could not stop RPC service")
+		}
+	}
+	s.detector.Stop()
+	if err := s.db.Close(); err != nil {
+		log.WithError(err).Error("Could not close slasher database")
+	}
+}
@@ -0,0 +1,111 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"google.golang.org/grpc"
+)
+
+// newGatewayServer builds an HTTP server that exposes the slasher's unary RPCs as REST
+// endpoints via grpc-gateway, and its two streaming RPCs as server-sent-events endpoints,
+// so consumers that cannot speak gRPC can still query and subscribe to slashings.
+func newGatewayServer(ctx context.Context, grpcAddress, gatewayHost string, gatewayPort int, corsDomains []string) *http.Server {
+	gwMux := gwruntime.NewServeMux()
+	dialOpts := []grpc.DialOption{grpc.WithInsecure()}
+	if err := slashpb.RegisterSlasherHandlerFromEndpoint(ctx, gwMux, grpcAddress, dialOpts); err != nil {
+		log.WithError(err).Error("Could not register gRPC gateway handlers")
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/slasher/v1/slashings/attestation/stream", sseBridge(ctx, grpcAddress, dialOpts, streamAttesterSlashings))
+	router.HandleFunc("/slasher/v1/slashings/block/stream", sseBridge(ctx, grpcAddress, dialOpts, streamProposerSlashings))
+	router.PathPrefix("/").Handler(gwMux)
+
+	return &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", gatewayHost, gatewayPort),
+		Handler: withCORS(router, corsDomains),
+	}
+}
+
+// withCORS allows browser-based clients on the configured origins to reach the gateway.
+// Access-Control-Allow-Origin must echo back a single origin (or "*"), never a joined
+// list, so each request is checked against allowedDomains individually.
+func withCORS(next http.Handler, allowedDomains []string) http.Handler {
+	allowed := make(map[string]bool, len(allowedDomains))
+	for _, d := range allowedDomains {
+		allowed[strings.TrimSpace(d)] = true
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if origin := r.Header.Get("Origin"); origin != "" && allowed[origin] {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		next.ServeHTTP(w, r)
+	})
+}
+
+// sseBridge turns a server-streaming gRPC call into a text/event-stream HTTP response,
+// so browser clients and simple HTTP tooling can subscribe without a gRPC client.
+func sseBridge(ctx context.Context, grpcAddress string, dialOpts []grpc.DialOption, stream func(ctx context.Context, conn *grpc.ClientConn, flush func(event string)) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+
+		conn, err := grpc.DialContext(r.Context(), grpcAddress, dialOpts...)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer conn.Close()
+
+		flush := func(event string) {
+			fmt.Fprintf(w, "data: %s\n\n", event)
+			flusher.Flush()
+		}
+		if err := stream(r.Context(), conn, flush); err != nil {
+			log.WithError(err).Debug("Slashing stream closed")
+		}
+	}
+}
+
+func streamAttesterSlashings(ctx context.Context, conn *grpc.ClientConn, flush func(event string)) error {
+	client := slashpb.NewSlasherClient(conn)
+	stream, err := client.StreamAttesterSlashings(ctx, &slashpb.StreamSlashingsRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		slashing, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		flush(slashing.String())
+	}
+}
+
+func streamProposerSlashings(ctx context.Context, conn *grpc.ClientConn, flush func(event string)) error {
+	client := slashpb.NewSlasherClient(conn)
+	stream, err := client.StreamProposerSlashings(ctx, &slashpb.StreamSlashingsRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		slashing, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		flush(slashing.String())
+	}
+}
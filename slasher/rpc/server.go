@@ -0,0 +1,185 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+)
+
+// Database is the subset of the slasher detector the RPC server needs to answer
+// queries. It is satisfied by the slasher's detection.Service.
+type Database interface {
+	DetectAttesterSlashings(ctx context.Context, att *ethpb.IndexedAttestation) ([]*ethpb.AttesterSlashing, error)
+	DetectProposerSlashing(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error)
+	HighestAttestation(ctx context.Context, validatorIndex uint64, epochStart uint64, epochEnd uint64) (sourceEpoch uint64, targetEpoch uint64, err error)
+}
+
+// Server defines the slasher gRPC service, implementing slashpb.SlasherServer.
+type Server struct {
+	ctx            context.Context
+	db             Database
+	connectedFeeds *feedSubscribers
+}
+
+// IsSlashableAttestation checks an indexed attestation against every attestation this
+// slasher has seen so far and returns an AttesterSlashing if it conflicts with one.
+func (s *Server) IsSlashableAttestation(ctx context.Context, att *ethpb.IndexedAttestation) (*slashpb.AttesterSlashingResponse, error) {
+	slashings, err := s.db.DetectAttesterSlashings(ctx, att)
+	if err != nil {
+		return nil, err
+	}
+	return &slashpb.AttesterSlashingResponse{AttesterSlashing: slashings}, nil
+}
+
+// IsSlashableBlock checks a signed block header against every block header this slasher
+// has seen so far and returns a ProposerSlashing if it conflicts with one.
+func (s *Server) IsSlashableBlock(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) (*slashpb.ProposerSlashingResponse, error) {
+	slashing, err := s.db.DetectProposerSlashing(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+	if slashing == nil {
+		return &slashpb.ProposerSlashingResponse{}, nil
+	}
+	return &slashpb.ProposerSlashingResponse{ProposerSlashing: []*ethpb.ProposerSlashing{slashing}}, nil
+}
+
+// HighestAttestation returns the highest source and target epoch validatorIndex has
+// attested to within [epoch_start, epoch_end].
+func (s *Server) HighestAttestation(ctx context.Context, req *slashpb.HighestAttestationRequest) (*slashpb.HighestAttestationResponse, error) {
+	sourceEpoch, targetEpoch, err := s.db.HighestAttestation(ctx, req.ValidatorIndex, req.EpochStart, req.EpochEnd)
+	if err != nil {
+		return nil, err
+	}
+	return &slashpb.HighestAttestationResponse{
+		HighestSourceEpoch: sourceEpoch,
+		HighestTargetEpoch: targetEpoch,
+	}, nil
+}
+
+// StreamAttesterSlashings streams every attester slashing this slasher detects for as
+// long as the client stays connected.
+func (s *Server) StreamAttesterSlashings(req *slashpb.StreamSlashingsRequest, stream slashpb.Slasher_StreamAttesterSlashingsServer) error {
+	sub := s.connectedFeeds.subscribeAttesterSlashings()
+	defer s.connectedFeeds.unsubscribeAttesterSlashings(sub)
+
+	for {
+		select {
+		case slashing := <-sub.ch:
+			if err := stream.Send(slashing); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+}
+
+// StreamProposerSlashings streams every proposer slashing this slasher detects for as
+// long as the client stays connected.
+func (s *Server) StreamProposerSlashings(req *slashpb.StreamSlashingsRequest, stream slashpb.Slasher_StreamProposerSlashingsServer) error {
+	sub := s.connectedFeeds.subscribeProposerSlashings()
+	defer s.connectedFeeds.unsubscribeProposerSlashings(sub)
+
+	for {
+		select {
+		case slashing := <-sub.ch:
+			if err := stream.Send(slashing); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+}
+
+// attesterSlashingSub is a single subscriber's channel for StreamAttesterSlashings.
+type attesterSlashingSub struct {
+	ch chan *ethpb.AttesterSlashing
+}
+
+// proposerSlashingSub is a single subscriber's channel for StreamProposerSlashings.
+type proposerSlashingSub struct {
+	ch chan *ethpb.ProposerSlashing
+}
+
+// feedSubscribers tracks every client currently subscribed to the attester and proposer
+// slashing streams, so a detected slashing can be broadcast to all of them.
+type feedSubscribers struct {
+	lock         sync.RWMutex
+	attesterSubs map[*attesterSlashingSub]bool
+	proposerSubs map[*proposerSlashingSub]bool
+}
+
+func newFeedSubscribers() *feedSubscribers {
+	return &feedSubscribers{
+		attesterSubs: make(map[*attesterSlashingSub]bool),
+		proposerSubs: make(map[*proposerSlashingSub]bool),
+	}
+}
+
+func (f *feedSubscribers) subscribeAttesterSlashings() *attesterSlashingSub {
+	sub := &attesterSlashingSub{ch: make(chan *ethpb.AttesterSlashing, 10)}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.attesterSubs[sub] = true
+	return sub
+}
+
+func (f *feedSubscribers) unsubscribeAttesterSlashings(sub *attesterSlashingSub) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	delete(f.attesterSubs, sub)
+}
+
+func (f *feedSubscribers) subscribeProposerSlashings() *proposerSlashingSub {
+	sub := &proposerSlashingSub{ch: make(chan *ethpb.ProposerSlashing, 10)}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.proposerSubs[sub] = true
+	return sub
+}
+
+func (f *feedSubscribers) unsubscribeProposerSlashings(sub *proposerSlashingSub) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	delete(f.proposerSubs, sub)
+}
+
+func (f *feedSubscribers) broadcastAttesterSlashing(ev interface{}) {
+	slashing, ok := ev.(*ethpb.AttesterSlashing)
+	if !ok {
+		return
+	}
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	for sub := range f.attesterSubs {
+		select {
+		case sub.ch <- slashing:
+		default:
+			log.Warn("Stream subscriber too slow to receive attester slashing, dropping it")
+		}
+	}
+}
+
+func (f *feedSubscribers) broadcastProposerSlashing(ev interface{}) {
+	slashing, ok := ev.(*ethpb.ProposerSlashing)
+	if !ok {
+		return
+	}
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	for sub := range f.proposerSubs {
+		select {
+		case sub.ch <- slashing:
+		default:
+			log.Warn("Stream subscriber too slow to receive proposer slashing, dropping it")
+		}
+	}
+}
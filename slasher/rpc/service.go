@@ -0,0 +1,170 @@
+// Package rpc defines a gRPC server, fronted by a grpc-gateway REST/SSE bridge, through
+// which downstream consumers such as block proposers and monitoring tools can query and
+// subscribe to slashings detected by a slasher node.
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+var log = logrus.WithField("prefix", "rpc")
+
+// Config options for the slasher RPC service.
+type Config struct {
+	Host                 string
+	Port                 string
+	CertFlag             string
+	KeyFlag              string
+	GatewayHost          string
+	GatewayPort          int
+	GatewayCorsDomains   []string
+	SlasherDB            Database
+	AttesterSlashingFeed *event.Feed
+	ProposerSlashingFeed *event.Feed
+}
+
+// Service defining an RPC server for a slasher node.
+type Service struct {
+	cfg            *Config
+	ctx            context.Context
+	cancel         context.CancelFunc
+	listener       net.Listener
+	withCert       bool
+	credentialErr  error
+	grpcServer     *grpc.Server
+	gatewayServer  *http.Server
+	healthServer   *health.Server
+	connectedFeeds *feedSubscribers
+}
+
+// NewService instantiates a new RPC service instance that will be registered into a main
+// slasher node, started, and shut down together with the rest of the node's services.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:            cfg,
+		ctx:            ctx,
+		cancel:         cancel,
+		withCert:       cfg.CertFlag != "" && cfg.KeyFlag != "",
+		healthServer:   health.NewServer(),
+		connectedFeeds: newFeedSubscribers(),
+	}
+}
+
+// Start the gRPC server, its grpc-gateway REST/SSE bridge, and begin relaying detected
+// slashings to any connected stream subscribers.
+func (s *Service) Start() {
+	address := fmt.Sprintf("%s:%s", s.cfg.Host, s.cfg.Port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.Errorf("Could not listen to port in Start() %s: %v", address, err)
+	}
+	s.listener = lis
+
+	opts := []grpc.ServerOption{
+		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
+		grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor),
+	}
+	if s.withCert {
+		creds, err := credentials.NewServerTLSFromFile(s.cfg.CertFlag, s.cfg.KeyFlag)
+		if err != nil {
+			log.Errorf("Could not load TLS keys: %s", err)
+			s.credentialErr = err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	} else {
+		log.Warn("You are using an insecure gRPC server. If you are running your slasher and " +
+			"its dependents on the same machines, you can ignore this message. If you are running " +
+			"these components on different machines, you should set up gRPC credentials.")
+	}
+	s.grpcServer = grpc.NewServer(opts...)
+
+	server := &Server{
+		ctx:            s.ctx,
+		db:             s.cfg.SlasherDB,
+		connectedFeeds: s.connectedFeeds,
+	}
+	slashpb.RegisterSlasherServer(s.grpcServer, server)
+	healthpb.RegisterHealthServer(s.grpcServer, s.healthServer)
+	reflection.Register(s.grpcServer)
+	grpc_prometheus.Register(s.grpcServer)
+
+	go s.relaySlashingFeeds()
+
+	if s.cfg.GatewayPort != 0 {
+		s.gatewayServer = newGatewayServer(s.ctx, address, s.cfg.GatewayHost, s.cfg.GatewayPort, s.cfg.GatewayCorsDomains)
+		go func() {
+			if err := s.gatewayServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.WithError(err).Error("Gateway server failed to start or closed unexpectedly")
+			}
+		}()
+	}
+
+	go func() {
+		if s.listener != nil {
+			if err := s.grpcServer.Serve(s.listener); err != nil {
+				log.Errorf("Could not serve gRPC: %v", err)
+			}
+		}
+	}()
+}
+
+// relaySlashingFeeds fans detected slashings out to every currently-subscribed stream
+// client, for as long as the service is running.
+func (s *Service) relaySlashingFeeds() {
+	attCh := make(chan interface{}, 100)
+	attSub := s.cfg.AttesterSlashingFeed.Subscribe(attCh)
+	defer attSub.Unsubscribe()
+
+	propCh := make(chan interface{}, 100)
+	propSub := s.cfg.ProposerSlashingFeed.Subscribe(propCh)
+	defer propSub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-attCh:
+			s.connectedFeeds.broadcastAttesterSlashing(ev)
+		case ev := <-propCh:
+			s.connectedFeeds.broadcastProposerSlashing(ev)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Stop the service, closing the gRPC server, the gateway bridge, and every open stream.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.listener != nil {
+		s.grpcServer.GracefulStop()
+	}
+	if s.gatewayServer != nil {
+		if err := s.gatewayServer.Close(); err != nil {
+			return errors.Wrap(err, "could not close gRPC gateway server")
+		}
+	}
+	return nil
+}
+
+// Status returns an error if the credentials failed to load at service start, so a
+// wider health check can surface the problem.
+func (s *Service) Status() error {
+	if s.credentialErr != nil {
+		return s.credentialErr
+	}
+	return nil
+}